@@ -0,0 +1,70 @@
+package simplejson
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+// Codec abstracts the JSON marshal/unmarshal pair used internally by
+// `Json`, so that callers who need more throughput than
+// `encoding/json` offers (many small documents: webhook payloads, log
+// lines) can swap in a drop-in replacement, or plug in an
+// easyjson/sonic-generated codec of their own, without touching any
+// other code in this package. A jsoniter-backed `Codec` is available
+// in the `jsoniter` subpackage (a separate module), so that pulling in
+// jsoniter and its transitive dependencies stays opt-in rather than a
+// cost every consumer of this package pays.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec is the default `Codec`, backed by the standard library.
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// codecHolder boxes a `Codec` so it can be stored in an `atomic.Value`,
+// which requires every `Store` to see the same concrete type.
+type codecHolder struct {
+	c Codec
+}
+
+// codecBox is the package-level `Codec` used by `UnmarshalJSON`,
+// `MarshalJSON` and `Encode`. It defaults to `encoding/json`.
+//
+// This is global, process-wide state, not per-`Json`: calling
+// `SetCodec` affects every `*Json` in the process, including ones
+// owned by unrelated packages that happen to share it.
+var codecBox atomic.Value
+
+func init() {
+	codecBox.Store(codecHolder{stdCodec{}})
+}
+
+func currentCodec() Codec {
+	return codecBox.Load().(codecHolder).c
+}
+
+// SetCodec replaces the package-level `Codec` used by `UnmarshalJSON`,
+// `MarshalJSON` and `Encode` (and so by `NewJson`). Passing `nil`
+// restores the `encoding/json` default. `NewJsonUseNumber`, `Stream`,
+// `ApplyPatch` and `ApplyMergePatch` always use `encoding/json`
+// directly and are not affected.
+//
+// SetCodec is global and process-wide, not scoped to a particular
+// `*Json` or goroutine: it's safe to call concurrently with
+// marshaling/unmarshaling, but every caller in the process sees the
+// new codec immediately.
+func SetCodec(c Codec) {
+	if c == nil {
+		c = stdCodec{}
+	}
+	codecBox.Store(codecHolder{c})
+}
@@ -0,0 +1,127 @@
+package simplejson
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+// NewJsonFromFileOptions controls how `NewJsonFromFileWithOptions`
+// relaxes strict JSON parsing for configuration-file use cases.
+type NewJsonFromFileOptions struct {
+	// AllowComments permits `//`-to-end-of-line and `/* */` comments.
+	AllowComments bool
+	// AllowTrailingCommas tolerates a trailing comma before a closing
+	// `}` or `]`.
+	AllowTrailingCommas bool
+	// LegacyHashComments preserves this package's original behavior of
+	// treating a line whose first non-whitespace character is `#` as a
+	// comment. Kept for back-compat with `NewJsonFromFile`; prefer
+	// AllowComments for new config files, since `#` is otherwise valid
+	// inside a JSON string.
+	LegacyHashComments bool
+}
+
+// NewJsonFromFileWithOptions is like `NewJsonFromFile`, but lets the
+// caller opt into `//`/`/* */` comments and trailing commas instead of
+// (or alongside) the `#`-comment behavior `NewJsonFromFile` defaults
+// to. Unlike the naive line splitter this replaces, it tokenizes with
+// proper string-literal/escape tracking, so a JSON string value that
+// happens to contain a newline or start with `#` is never corrupted.
+func NewJsonFromFileWithOptions(filename string, opts NewJsonFromFileOptions) (*Json, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return NewJson([]byte(stripRelaxedJSON(string(raw), opts)))
+}
+
+// stripRelaxedJSON strips the comments and trailing commas `opts`
+// allows for, leaving everything inside string literals untouched.
+func stripRelaxedJSON(content string, opts NewJsonFromFileOptions) string {
+	var out strings.Builder
+	runes := []rune(content)
+	n := len(runes)
+	inString := false
+	escaped := false
+
+	for i := 0; i < n; i++ {
+		c := runes[i]
+
+		if inString {
+			out.WriteRune(c)
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		if opts.LegacyHashComments && c == '#' && atLineStart(runes, i) {
+			for i < n && runes[i] != '\n' {
+				i++
+			}
+			i--
+			continue
+		}
+
+		if opts.AllowComments && c == '/' && i+1 < n && runes[i+1] == '/' {
+			i += 2
+			for i < n && runes[i] != '\n' {
+				i++
+			}
+			i--
+			continue
+		}
+
+		if opts.AllowComments && c == '/' && i+1 < n && runes[i+1] == '*' {
+			i += 2
+			for i+1 < n && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			continue
+		}
+
+		if c == '"' {
+			inString = true
+			out.WriteRune(c)
+			continue
+		}
+
+		if opts.AllowTrailingCommas && c == ',' {
+			j := i + 1
+			for j < n && isJSONSpace(runes[j]) {
+				j++
+			}
+			if j < n && (runes[j] == '}' || runes[j] == ']') {
+				continue
+			}
+		}
+
+		out.WriteRune(c)
+	}
+
+	return out.String()
+}
+
+// atLineStart reports whether position i is preceded only by
+// whitespace since the start of the content or the last newline.
+func atLineStart(runes []rune, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		if runes[j] == '\n' {
+			return true
+		}
+		if !isJSONSpace(runes[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isJSONSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r' || r == '\n'
+}
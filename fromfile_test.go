@@ -0,0 +1,101 @@
+package simplejson
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewJsonFromFileLegacyHashComments(t *testing.T) {
+	path := writeTempFile(t, "# leading comment\n{\n  # also a comment\n  \"a\": 1\n}\n")
+	js, err := NewJsonFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := js.Get("a").Int(); v != 1 {
+		t.Fatalf("a = %d, want 1", v)
+	}
+}
+
+func TestNewJsonFromFileDoesNotCorruptStringsStartingWithHash(t *testing.T) {
+	path := writeTempFile(t, "{\"a\": \"#not-a-comment\\nstill the value\"}")
+	js, err := NewJsonFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := js.Get("a").String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "#not-a-comment\nstill the value" {
+		t.Fatalf("a = %q, want the string preserved verbatim", s)
+	}
+}
+
+func TestNewJsonFromFileWithOptionsAllowComments(t *testing.T) {
+	path := writeTempFile(t, `{
+		// line comment
+		"a": 1, /* inline comment */
+		"b": 2,
+	}`)
+	js, err := NewJsonFromFileWithOptions(path, NewJsonFromFileOptions{
+		AllowComments:       true,
+		AllowTrailingCommas: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := js.Get("a").Int(); v != 1 {
+		t.Fatalf("a = %d, want 1", v)
+	}
+	if v, _ := js.Get("b").Int(); v != 2 {
+		t.Fatalf("b = %d, want 2", v)
+	}
+}
+
+func TestNewJsonFromFileWithOptionsTrailingCommaInArray(t *testing.T) {
+	path := writeTempFile(t, `{"list": [1, 2, 3,]}`)
+	js, err := NewJsonFromFileWithOptions(path, NewJsonFromFileOptions{AllowTrailingCommas: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := js.Get("list").IntArray()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("list = %v, want length 3", list)
+	}
+}
+
+func TestNewJsonFromFileWithOptionsNoAllowancesIsStrict(t *testing.T) {
+	path := writeTempFile(t, `{"a": 1,}`)
+	if _, err := NewJsonFromFileWithOptions(path, NewJsonFromFileOptions{}); err == nil {
+		t.Fatal("expected trailing comma to be rejected without AllowTrailingCommas")
+	}
+}
+
+func TestNewJsonFromFileWithOptionsUnterminatedBlockCommentDoesNotHang(t *testing.T) {
+	path := writeTempFile(t, `{"a": 1 /* never closed`)
+	_, err := NewJsonFromFileWithOptions(path, NewJsonFromFileOptions{AllowComments: true})
+	if err == nil {
+		t.Fatal("expected an error decoding JSON truncated by an unterminated comment")
+	}
+}
+
+func TestNewJsonFromFileMissingFileErrors(t *testing.T) {
+	if _, err := NewJsonFromFile(filepath.Join(os.TempDir(), "does-not-exist.json")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
@@ -0,0 +1,30 @@
+// Package jsoniter adapts github.com/json-iterator/go to
+// simplejson.Codec, kept as a separate module so that the core
+// go-simplejson package stays dependency-free for consumers who never
+// call SetCodec.
+package jsoniter
+
+import (
+	simplejson "github.com/ckrissun/go-simplejson"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// codec adapts github.com/json-iterator/go to simplejson.Codec.
+type codec struct {
+	api jsoniter.API
+}
+
+// Codec returns a simplejson.Codec backed by jsoniter's
+// `ConfigCompatibleWithStandardLibrary` configuration, ready to pass to
+// `simplejson.SetCodec`.
+func Codec() simplejson.Codec {
+	return codec{api: jsoniter.ConfigCompatibleWithStandardLibrary}
+}
+
+func (c codec) Marshal(v interface{}) ([]byte, error) {
+	return c.api.Marshal(v)
+}
+
+func (c codec) Unmarshal(data []byte, v interface{}) error {
+	return c.api.Unmarshal(data, v)
+}
@@ -0,0 +1,48 @@
+package jsoniter
+
+import (
+	"testing"
+
+	simplejson "github.com/ckrissun/go-simplejson"
+)
+
+var benchFixture = []byte(`{
+	"id": 123456789012345,
+	"name": "go-simplejson",
+	"active": true,
+	"tags": ["json", "config", "fast"],
+	"meta": {
+		"owner": "ckrissun",
+		"stars": 42,
+		"score": 9.5
+	}
+}`)
+
+func BenchmarkDecode(b *testing.B) {
+	simplejson.SetCodec(Codec())
+	defer simplejson.SetCodec(nil)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejson.NewJson(benchFixture); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	simplejson.SetCodec(Codec())
+	defer simplejson.SetCodec(nil)
+
+	js, err := simplejson.NewJson(benchFixture)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := js.Encode(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
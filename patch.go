@@ -0,0 +1,304 @@
+package simplejson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// patchOp is a single RFC 6902 JSON Patch operation.
+type patchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from"`
+	Value interface{} `json:"value"`
+}
+
+// pointerTokens splits an RFC 6901 JSON Pointer ("/a/b/0") into its
+// unescaped reference tokens.
+func pointerTokens(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("simplejson: JSON pointer %q must start with '/'", pointer)
+	}
+
+	parts := strings.Split(pointer[1:], "/")
+	for i, p := range parts {
+		p = strings.Replace(p, "~1", "/", -1)
+		p = strings.Replace(p, "~0", "~", -1)
+		parts[i] = p
+	}
+	return parts, nil
+}
+
+func arrayIndex(tok string, length int) (int, error) {
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("simplejson: invalid array index %q", tok)
+	}
+	if idx < 0 || idx >= length {
+		return 0, fmt.Errorf("simplejson: array index %d out of range", idx)
+	}
+	return idx, nil
+}
+
+// navigate walks `tokens` from the root of `j`, returning a get/set
+// pair addressing the container found at that path, so a caller can
+// both read and replace it in place (needed since replacing an array
+// means replacing the slice held by its parent).
+func navigate(j *Json, tokens []string) (get func() interface{}, set func(interface{}), err error) {
+	get = func() interface{} { return j.data }
+	set = func(v interface{}) { j.data = v }
+
+	for _, t := range tokens {
+		switch c := get().(type) {
+		case map[string]interface{}:
+			tok, container := t, c
+			get = func() interface{} { return container[tok] }
+			set = func(v interface{}) { container[tok] = v }
+		case []interface{}:
+			idx, idxErr := arrayIndex(t, len(c))
+			if idxErr != nil {
+				return nil, nil, idxErr
+			}
+			container := c
+			get = func() interface{} { return container[idx] }
+			set = func(v interface{}) { container[idx] = v }
+		default:
+			return nil, nil, fmt.Errorf("simplejson: cannot descend into %T at %q", c, t)
+		}
+	}
+	return get, set, nil
+}
+
+func pointerGet(j *Json, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return j.data, nil
+	}
+	parentGet, _, err := navigate(j, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch p := parentGet().(type) {
+	case map[string]interface{}:
+		v, ok := p[last]
+		if !ok {
+			return nil, fmt.Errorf("simplejson: path segment %q not found", last)
+		}
+		return v, nil
+	case []interface{}:
+		idx, err := arrayIndex(last, len(p))
+		if err != nil {
+			return nil, err
+		}
+		return p[idx], nil
+	default:
+		return nil, fmt.Errorf("simplejson: cannot read path segment %q from %T", last, p)
+	}
+}
+
+// pointerSet writes `val` at `tokens`. When `insert` is true, an array
+// target grows by one element (appending for the "-" token, otherwise
+// shifting elements right of the index), matching the "add" semantics
+// of RFC 6902; when false, it overwrites in place, matching "replace".
+func pointerSet(j *Json, tokens []string, val interface{}, insert bool) error {
+	if len(tokens) == 0 {
+		j.data = val
+		return nil
+	}
+	parentGet, parentSet, err := navigate(j, tokens[:len(tokens)-1])
+	if err != nil {
+		return err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch p := parentGet().(type) {
+	case map[string]interface{}:
+		p[last] = val
+		return nil
+	case []interface{}:
+		if !insert {
+			idx, err := arrayIndex(last, len(p))
+			if err != nil {
+				return err
+			}
+			p[idx] = val
+			return nil
+		}
+		if last == "-" {
+			parentSet(append(p, val))
+			return nil
+		}
+		idx, err := strconv.Atoi(last)
+		if err != nil || idx < 0 || idx > len(p) {
+			return fmt.Errorf("simplejson: invalid array index %q", last)
+		}
+		out := make([]interface{}, 0, len(p)+1)
+		out = append(out, p[:idx]...)
+		out = append(out, val)
+		out = append(out, p[idx:]...)
+		parentSet(out)
+		return nil
+	default:
+		return fmt.Errorf("simplejson: cannot set path segment %q on %T", last, p)
+	}
+}
+
+func pointerRemove(j *Json, tokens []string) error {
+	if len(tokens) == 0 {
+		j.data = nil
+		return nil
+	}
+	parentGet, parentSet, err := navigate(j, tokens[:len(tokens)-1])
+	if err != nil {
+		return err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch p := parentGet().(type) {
+	case map[string]interface{}:
+		if _, ok := p[last]; !ok {
+			return fmt.Errorf("simplejson: path segment %q not found", last)
+		}
+		delete(p, last)
+		return nil
+	case []interface{}:
+		idx, err := arrayIndex(last, len(p))
+		if err != nil {
+			return err
+		}
+		parentSet(append(p[:idx], p[idx+1:]...))
+		return nil
+	default:
+		return fmt.Errorf("simplejson: cannot remove path segment %q from %T", last, p)
+	}
+}
+
+// deepCopyValue returns a deep copy of v, a tree of
+// map[string]interface{}/[]interface{}/scalars as produced by
+// encoding/json, so that a "copy" patch op duplicates the value (RFC
+// 6902 §4.5) instead of aliasing the map/slice already held by the tree.
+func deepCopyValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = deepCopyValue(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = deepCopyValue(val)
+		}
+		return out
+	default:
+		return t
+	}
+}
+
+// ApplyPatch mutates `j` in place by applying an RFC 6902 JSON Patch
+// document: an ordered list of `add`, `remove`, `replace`, `move`,
+// `copy` and `test` operations, each addressed via an RFC 6901 JSON
+// Pointer.
+func (j *Json) ApplyPatch(patch []byte) error {
+	var ops []patchOp
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		tokens, err := pointerTokens(op.Path)
+		if err != nil {
+			return err
+		}
+
+		switch op.Op {
+		case "add":
+			err = pointerSet(j, tokens, op.Value, true)
+		case "replace":
+			err = pointerSet(j, tokens, op.Value, false)
+		case "remove":
+			err = pointerRemove(j, tokens)
+		case "move", "copy":
+			if op.From == "" {
+				return fmt.Errorf("simplejson: patch op %q missing \"from\"", op.Op)
+			}
+			var fromTokens []string
+			fromTokens, err = pointerTokens(op.From)
+			if err != nil {
+				return err
+			}
+			var val interface{}
+			val, err = pointerGet(j, fromTokens)
+			if err != nil {
+				return err
+			}
+			if op.Op == "move" {
+				if err = pointerRemove(j, fromTokens); err != nil {
+					return err
+				}
+			} else {
+				// "copy" must duplicate the value (RFC 6902 §4.5), not
+				// alias the map/slice already held by the tree.
+				val = deepCopyValue(val)
+			}
+			err = pointerSet(j, tokens, val, true)
+		case "test":
+			var val interface{}
+			val, err = pointerGet(j, tokens)
+			if err != nil {
+				return err
+			}
+			valB, _ := json.Marshal(val)
+			expB, _ := json.Marshal(op.Value)
+			if string(valB) != string(expB) {
+				err = fmt.Errorf("simplejson: test failed for path %q", op.Path)
+			}
+		default:
+			err = fmt.Errorf("simplejson: unknown patch op %q", op.Op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyMergePatch mutates `j` in place according to RFC 7396 JSON
+// Merge Patch: objects are merged recursively, a `null` value deletes
+// the corresponding key, and any other value overwrites it outright.
+func (j *Json) ApplyMergePatch(patch []byte) error {
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return err
+	}
+	j.data = mergePatch(j.data, p)
+	return nil
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		targetMap = make(map[string]interface{})
+	}
+
+	for k, v := range patchMap {
+		if v == nil {
+			delete(targetMap, k)
+			continue
+		}
+		targetMap[k] = mergePatch(targetMap[k], v)
+	}
+	return targetMap
+}
@@ -0,0 +1,167 @@
+package simplejson
+
+import "testing"
+
+func TestSetPathCreatesIntermediateMaps(t *testing.T) {
+	js, _ := NewJson([]byte(`{}`))
+	js.SetPath([]string{"a", "b", "c"}, 42)
+
+	v, err := js.GetPath("a", "b", "c").Int()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 42 {
+		t.Fatalf("v = %d, want 42", v)
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":{"b":1,"c":2}}`))
+	js.DeletePath("a", "b")
+
+	if !js.GetPath("a", "b").IsNull() {
+		t.Fatal("expected a.b to be deleted")
+	}
+	if v, _ := js.GetPath("a", "c").Int(); v != 2 {
+		t.Fatalf("a.c = %d, want 2 (should be untouched)", v)
+	}
+}
+
+func TestApplyPatchAddReplaceRemove(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":1,"list":[1,2,3]}`))
+
+	patch := []byte(`[
+		{"op": "add", "path": "/b", "value": 2},
+		{"op": "replace", "path": "/a", "value": 99},
+		{"op": "remove", "path": "/list/1"},
+		{"op": "add", "path": "/list/-", "value": 4}
+	]`)
+	if err := js.ApplyPatch(patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := js.Get("a").Int(); v != 99 {
+		t.Fatalf("a = %d, want 99", v)
+	}
+	if v, _ := js.Get("b").Int(); v != 2 {
+		t.Fatalf("b = %d, want 2", v)
+	}
+	list := js.Get("list").MustArray()
+	if len(list) != 3 {
+		t.Fatalf("list = %v, want length 3", list)
+	}
+}
+
+func TestApplyPatchMoveCopy(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":{"x":1}}`))
+
+	patch := []byte(`[
+		{"op": "copy", "from": "/a/x", "path": "/a/y"},
+		{"op": "move", "from": "/a/x", "path": "/a/z"}
+	]`)
+	if err := js.ApplyPatch(patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if !js.GetPath("a", "x").IsNull() {
+		t.Fatal("expected a.x to be moved away")
+	}
+	if v, _ := js.GetPath("a", "y").Int(); v != 1 {
+		t.Fatalf("a.y = %d, want 1", v)
+	}
+	if v, _ := js.GetPath("a", "z").Int(); v != 1 {
+		t.Fatalf("a.z = %d, want 1", v)
+	}
+}
+
+func TestApplyPatchCopyDuplicatesRatherThanAliases(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":{"x":{"n":1}}}`))
+
+	patch := []byte(`[{"op": "copy", "from": "/a/x", "path": "/a/y"}]`)
+	if err := js.ApplyPatch(patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := js.ApplyPatch([]byte(`[{"op": "replace", "path": "/a/y/n", "value": 2}]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := js.GetPath("a", "y", "n").Int(); v != 2 {
+		t.Fatalf("a.y.n = %d, want 2", v)
+	}
+	if v, _ := js.GetPath("a", "x", "n").Int(); v != 1 {
+		t.Fatalf("a.x.n = %d, want unchanged 1 (copy must not alias the source)", v)
+	}
+}
+
+func TestApplyPatchMoveCopyMissingFromErrors(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":1}`))
+
+	if err := js.ApplyPatch([]byte(`[{"op": "copy", "path": "/b"}]`)); err == nil {
+		t.Fatal("expected error for copy op missing \"from\"")
+	}
+	if err := js.ApplyPatch([]byte(`[{"op": "move", "path": "/b"}]`)); err == nil {
+		t.Fatal("expected error for move op missing \"from\"")
+	}
+	if v, _ := js.Get("a").Int(); v != 1 {
+		t.Fatalf("a = %d, want unchanged 1 after rejected patch", v)
+	}
+}
+
+func TestApplyPatchTestFailureAborts(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":1}`))
+
+	patch := []byte(`[
+		{"op": "test", "path": "/a", "value": 2},
+		{"op": "replace", "path": "/a", "value": 99}
+	]`)
+	if err := js.ApplyPatch(patch); err == nil {
+		t.Fatal("expected test op to fail and abort the patch")
+	}
+	if v, _ := js.Get("a").Int(); v != 1 {
+		t.Fatalf("a = %d, want unchanged 1 after failed test", v)
+	}
+}
+
+func TestApplyPatchOutOfRangeIndexErrors(t *testing.T) {
+	js, _ := NewJson([]byte(`{"list":[1,2]}`))
+	patch := []byte(`[{"op": "replace", "path": "/list/5", "value": 1}]`)
+	if err := js.ApplyPatch(patch); err == nil {
+		t.Fatal("expected error for out-of-range array index")
+	}
+}
+
+func TestApplyPatchMalformedErrors(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":1}`))
+	if err := js.ApplyPatch([]byte(`not json`)); err == nil {
+		t.Fatal("expected error for malformed patch document")
+	}
+	if err := js.ApplyPatch([]byte(`[{"op": "frobnicate", "path": "/a"}]`)); err == nil {
+		t.Fatal("expected error for unknown op")
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	js, _ := NewJson([]byte(`{"a":1,"b":{"x":1,"y":2},"c":3}`))
+
+	patch := []byte(`{"a":2,"b":{"x":null,"z":3},"c":null}`)
+	if err := js.ApplyMergePatch(patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := js.Get("a").Int(); v != 2 {
+		t.Fatalf("a = %d, want 2", v)
+	}
+	if !js.GetPath("c").IsNull() {
+		t.Fatal("expected c to be deleted")
+	}
+	if !js.GetPath("b", "x").IsNull() {
+		t.Fatal("expected b.x to be deleted")
+	}
+	if v, _ := js.GetPath("b", "y").Int(); v != 2 {
+		t.Fatalf("b.y = %d, want 2 (untouched)", v)
+	}
+	if v, _ := js.GetPath("b", "z").Int(); v != 3 {
+		t.Fatalf("b.z = %d, want 3", v)
+	}
+}
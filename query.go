@@ -0,0 +1,125 @@
+package simplejson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSeg is one step of a parsed JSONPath-like expression: a map key,
+// an array index (possibly negative), or a `[*]` wildcard.
+type pathSeg struct {
+	key      string
+	isIndex  bool
+	index    int
+	wildcard bool
+}
+
+func parseQuery(expr string) ([]pathSeg, error) {
+	expr = strings.TrimSpace(expr)
+	expr = strings.TrimPrefix(expr, "$")
+
+	var segs []pathSeg
+	i, n := 0, len(expr)
+	for i < n {
+		switch expr[i] {
+		case '.':
+			i++
+		case '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("simplejson: unterminated '[' in query %q", expr)
+			}
+			inner := expr[i+1 : i+end]
+			i += end + 1
+
+			if inner == "*" {
+				segs = append(segs, pathSeg{wildcard: true})
+				continue
+			}
+			idx, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("simplejson: invalid index %q in query %q", inner, expr)
+			}
+			segs = append(segs, pathSeg{isIndex: true, index: idx})
+		default:
+			end := i
+			for end < n && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+			segs = append(segs, pathSeg{key: expr[i:end]})
+			i = end
+		}
+	}
+	return segs, nil
+}
+
+// Query evaluates a JSONPath-like expression (`$.a.b[0].c`, negative
+// indices such as `a[-1]`, `[*]` wildcards) against `j` and returns the
+// first match, or a wrapped nil `Json` if nothing matches - mirroring
+// the sentinel behavior of `Get` and `GetPath`. This saves chaining
+// `Get`/`GetIndex` calls or pre-splitting keys for `GetPath` when
+// walking loosely-shaped API responses.
+//
+//    js.Query("$.results[0].items[*].id")
+func (j *Json) Query(expr string) (*Json, error) {
+	results, err := j.QueryAll(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return &Json{nil}, nil
+	}
+	return results[0], nil
+}
+
+// QueryAll evaluates a JSONPath-like expression against `j` and returns
+// every match, expanding `[*]` wildcards into the result slice. See
+// `Query` for the supported syntax.
+func (j *Json) QueryAll(expr string) ([]*Json, error) {
+	segs, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	return walkQuery([]*Json{j}, segs), nil
+}
+
+func walkQuery(cur []*Json, segs []pathSeg) []*Json {
+	for _, seg := range segs {
+		var next []*Json
+		for _, c := range cur {
+			switch {
+			case seg.key != "":
+				if m, err := c.Map(); err == nil {
+					if val, ok := m[seg.key]; ok {
+						next = append(next, &Json{val})
+					}
+				}
+			case seg.wildcard:
+				if a, err := c.Array(); err == nil {
+					for _, v := range a {
+						next = append(next, &Json{v})
+					}
+				} else if m, err := c.Map(); err == nil {
+					for _, v := range m {
+						next = append(next, &Json{v})
+					}
+				}
+			case seg.isIndex:
+				a, err := c.Array()
+				if err != nil {
+					continue
+				}
+				idx := seg.index
+				if idx < 0 {
+					idx += len(a)
+				}
+				if idx >= 0 && idx < len(a) {
+					next = append(next, &Json{a[idx]})
+				}
+			}
+		}
+		cur = next
+	}
+	return cur
+}
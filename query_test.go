@@ -0,0 +1,81 @@
+package simplejson
+
+import "testing"
+
+func testDoc(t *testing.T) *Json {
+	t.Helper()
+	js, err := NewJson([]byte(`{
+		"results": [
+			{"id": 1, "tags": ["a", "b"]},
+			{"id": 2, "tags": ["c"]}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return js
+}
+
+func TestQueryDottedPath(t *testing.T) {
+	js := testDoc(t)
+	got, err := js.Query("$.results[0].id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := got.Int(); id != 1 {
+		t.Fatalf("id = %d, want 1", id)
+	}
+}
+
+func TestQueryNegativeIndex(t *testing.T) {
+	js := testDoc(t)
+	got, err := js.Query("results[-1].id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := got.Int(); id != 2 {
+		t.Fatalf("id = %d, want 2", id)
+	}
+}
+
+func TestQueryNoMatchReturnsNilSentinel(t *testing.T) {
+	js := testDoc(t)
+	got, err := js.Query("results[99].id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsNull() {
+		t.Fatalf("expected a nil sentinel Json, got %v", got.GetData())
+	}
+}
+
+func TestQueryAllWildcard(t *testing.T) {
+	js := testDoc(t)
+	got, err := js.QueryAll("results[*].id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(got) = %d, want 2", len(got))
+	}
+	if id0, _ := got[0].Int(); id0 != 1 {
+		t.Fatalf("got[0] = %d, want 1", id0)
+	}
+	if id1, _ := got[1].Int(); id1 != 2 {
+		t.Fatalf("got[1] = %d, want 2", id1)
+	}
+}
+
+func TestQueryUnterminatedBracketErrors(t *testing.T) {
+	js := testDoc(t)
+	if _, err := js.Query("results[0"); err == nil {
+		t.Fatal("expected error for unterminated '['")
+	}
+}
+
+func TestQueryInvalidIndexErrors(t *testing.T) {
+	js := testDoc(t)
+	if _, err := js.Query("results[foo]"); err == nil {
+		t.Fatal("expected error for non-numeric, non-wildcard index")
+	}
+}
@@ -5,8 +5,7 @@ import (
 	"errors"
 	"log"
 	"bytes"
-	"strings"
-	"io/ioutil"
+	"os"
 )
 
 // returns the current implementation version
@@ -29,25 +28,44 @@ func NewJson(body []byte) (*Json, error) {
 	return j, nil
 }
 
+// NewJsonUseNumber returns a pointer to a new `Json` object after
+// unmarshaling `body` bytes, decoding numeric values as `json.Number`
+// instead of `float64` so that integers wider than 2^53 (snowflake-style
+// IDs, for example) survive the round trip without losing precision.
+//
+// This always decodes via `encoding/json.Decoder` directly, so unlike
+// `NewJson` it does not go through the package-level `Codec` set by
+// `SetCodec` - `UseNumber` has no equivalent in the `Codec` interface.
+func NewJsonUseNumber(body []byte) (*Json, error) {
+	j := new(Json)
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&j.data); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
 // NewJsonFromFile return a pointer to a new `Json` object
-// after unmarshalling a json file
+// after unmarshalling a json file, tolerating `#`-prefixed comment
+// lines for back-compat with earlier versions of this package. Use
+// `NewJsonFromFileWithOptions` to allow `//`/`/* */` comments and
+// trailing commas instead.
 // forked from github.com/polaris1119/autogo/src/simplejson
 func NewJsonFromFile(filename string) (*Json, error) {
-    stream, err := ioutil.ReadFile(filename)
-    if err != nil {
-        return nil, err
-    }
-    content := string(stream)
-    var builder bytes.Buffer
-    lines := strings.Split(content, "\n")
-    for _, line := range lines {
-        line = strings.TrimSpace(line)
-        if line == "" || strings.HasPrefix(line, "#") {
-            continue
-        }
-        builder.WriteString(line)
-    }
-    return NewJson(builder.Bytes())
+    return NewJsonFromFileWithOptions(filename, NewJsonFromFileOptions{LegacyHashComments: true})
+}
+
+// NewJsonStreamFromFile opens `filename` and returns a pointer to a new
+// `Stream` reading from it, without slurping the whole file into memory
+// first the way `NewJsonFromFile` does. Callers are responsible for
+// calling `Close` on the returned `Stream` once they're done with it.
+func NewJsonStreamFromFile(filename string) (*Stream, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	return NewStream(f), nil
 }
 
 // Set Data of Json
@@ -102,13 +120,19 @@ func (j *Json) Encode() ([]byte, error) {
 }
 
 // Implements the json.Unmarshaler interface.
+//
+// Goes through the package-level `Codec` (see `SetCodec`), so it
+// honors a jsoniter or other drop-in replacement if one has been set.
 func (j *Json) UnmarshalJSON(p []byte) error {
-	return json.Unmarshal(p, &j.data)
+	return currentCodec().Unmarshal(p, &j.data)
 }
 
 // Implements the json.Marshaler interface.
+//
+// Goes through the package-level `Codec` (see `SetCodec`), so it
+// honors a jsoniter or other drop-in replacement if one has been set.
 func (j *Json) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&j.data)
+	return currentCodec().Marshal(&j.data)
 }
 
 // Set modifies `Json` map by `key` and `value`
@@ -173,6 +197,73 @@ func (j *Json) GetPath(branch ...string) *Json {
 	return jin
 }
 
+// SetPath modifies `Json`, recursively checking/creating map keys for
+// the supplied path, and then finally writing in the value
+func (j *Json) SetPath(branch []string, val interface{}) {
+	if len(branch) == 0 {
+		j.data = val
+		return
+	}
+
+	// in order to insert our branch, we need map[string]interface{}
+	if _, ok := (j.data).(map[string]interface{}); !ok {
+		j.data = make(map[string]interface{})
+	}
+	curr := j.data.(map[string]interface{})
+
+	for i := 0; i < len(branch)-1; i++ {
+		b := branch[i]
+
+		// key exists?
+		if _, ok := curr[b]; !ok {
+			n := make(map[string]interface{})
+			curr[b] = n
+			curr = n
+			continue
+		}
+
+		// make sure the value is the right type
+		if _, ok := curr[b].(map[string]interface{}); !ok {
+			n := make(map[string]interface{})
+			curr[b] = n
+		}
+
+		curr = curr[b].(map[string]interface{})
+	}
+
+	// set the value
+	curr[branch[len(branch)-1]] = val
+}
+
+// DeletePath deletes the value for the specified path, without the
+// need to deep dive using Delete()'s.
+//
+//   js.DeletePath("top_level", "dict")
+func (j *Json) DeletePath(branch ...string) *Json {
+	if len(branch) == 0 {
+		j.data = nil
+		return j
+	}
+
+	jin := j
+	for i := 0; i < len(branch)-1; i++ {
+		m, err := jin.Map()
+		if err != nil {
+			return j
+		}
+		val, ok := m[branch[i]]
+		if !ok {
+			return j
+		}
+		jin = &Json{val}
+	}
+
+	if m, err := jin.Map(); err == nil {
+		delete(m, branch[len(branch)-1])
+	}
+	return j
+}
+
 // GetIndex resturns a pointer to a new `Json` object
 // for `index` in its `array` representation
 //
@@ -240,12 +331,24 @@ func (j *Json) String() (string, error) {
 
 // Float64 type asserts to `float64`
 func (j *Json) Float64() (float64, error) {
-	if i, ok := (j.data).(float64); ok {
-		return i, nil
-	}
+  switch f := (j.data).(type) {
+  case float64:
+    return f, nil
+  case json.Number:
+    return f.Float64()
+  }
 	return -1, errors.New("type assertion to float64 failed")
 }
 
+// Number type asserts to `json.Number`, the representation used for
+// numeric leaves when the `Json` was decoded via `NewJsonUseNumber`
+func (j *Json) Number() (json.Number, error) {
+	if s, ok := (j.data).(json.Number); ok {
+		return s, nil
+	}
+	return "", errors.New("type assertion to json.Number failed")
+}
+
 // Int type asserts to `float64` then converts to `int`
 func (j *Json) Int() (int, error) {
   switch f := (j.data).(type) {
@@ -255,6 +358,12 @@ func (j *Json) Int() (int, error) {
     return f, nil
   case int64:
     return int(f), nil
+  case json.Number:
+    i, err := f.Int64()
+    if err != nil {
+      return -1, err
+    }
+    return int(i), nil
   }
 
 	return -1, errors.New("type assertion to int failed")
@@ -269,6 +378,8 @@ func (j *Json) Int64() (int64, error) {
     return int64(f), nil
   case int64:
     return f, nil
+  case json.Number:
+    return f.Int64()
   }
 
 	return -1, errors.New("type assertion to int64 failed")
@@ -311,11 +422,18 @@ func (j *Json) Int64Array() ([]int64, error) {
   case []interface{}:
 	  retArr := make([]int64, 0, len(arr))
     for _, a := range arr {
-      s, ok := a.(float64)
-      if !ok {
+      switch s := a.(type) {
+      case float64:
+        retArr = append(retArr, int64(s))
+      case json.Number:
+        i, err := s.Int64()
+        if err != nil {
+          return nil, err
+        }
+        retArr = append(retArr, i)
+      default:
         return nil, errors.New("type assertion to float64 failed")
       }
-      retArr = append(retArr, int64(s))
 	  }
 	  return retArr, nil
 	case []int64:
@@ -340,11 +458,18 @@ func (j *Json) IntArray() ([]int, error) {
   case []interface{}:
 	  retArr := make([]int, 0, len(arr))
     for _, a := range arr {
-      s, ok := a.(float64)
-      if !ok {
+      switch s := a.(type) {
+      case float64:
+        retArr = append(retArr, int(s))
+      case json.Number:
+        i, err := s.Int64()
+        if err != nil {
+          return nil, err
+        }
+        retArr = append(retArr, int(i))
+      default:
         return nil, errors.New("type assertion to float64 failed")
       }
-      retArr = append(retArr, int(s))
 	  }
 	  return retArr, nil
 	case []int64:
@@ -0,0 +1,58 @@
+package simplejson
+
+import "testing"
+
+// benchFixture is a small, representative document (nested objects,
+// an array, mixed scalar types) used to compare codecs.
+var benchFixture = []byte(`{
+	"id": 123456789012345,
+	"name": "go-simplejson",
+	"active": true,
+	"tags": ["json", "config", "fast"],
+	"meta": {
+		"owner": "ckrissun",
+		"stars": 42,
+		"score": 9.5
+	}
+}`)
+
+func benchmarkDecode(b *testing.B, c Codec) {
+	SetCodec(c)
+	defer SetCodec(nil)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewJson(benchFixture); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkEncode(b *testing.B, c Codec) {
+	SetCodec(c)
+	defer SetCodec(nil)
+
+	js, err := NewJson(benchFixture)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := js.Encode(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeStdlib(b *testing.B) {
+	benchmarkDecode(b, stdCodec{})
+}
+
+func BenchmarkEncodeStdlib(b *testing.B) {
+	benchmarkEncode(b, stdCodec{})
+}
+
+// The jsoniter codec has its own benchmark, in the `jsoniter`
+// subpackage, since pulling jsoniter into this module just to compare
+// it would defeat the point of keeping the core dependency-free.
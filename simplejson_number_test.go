@@ -0,0 +1,67 @@
+package simplejson
+
+import "testing"
+
+func TestNewJsonUseNumberPreservesPrecision(t *testing.T) {
+	js, err := NewJsonUseNumber([]byte(`{"id": 9223372036854775807, "score": 9.5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := js.Get("id").Number()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.String() != "9223372036854775807" {
+		t.Fatalf("Number() = %q, want %q", n.String(), "9223372036854775807")
+	}
+
+	i, err := js.Get("id").Int64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != 9223372036854775807 {
+		t.Fatalf("Int64() = %d, want 9223372036854775807", i)
+	}
+
+	f, err := js.Get("score").Float64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != 9.5 {
+		t.Fatalf("Float64() = %v, want 9.5", f)
+	}
+}
+
+func TestIntArraysAcceptJSONNumber(t *testing.T) {
+	js, err := NewJsonUseNumber([]byte(`{"ids": [1, 2, 3]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ints, err := js.Get("ids").IntArray()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ints) != 3 || ints[0] != 1 || ints[2] != 3 {
+		t.Fatalf("IntArray() = %v, want [1 2 3]", ints)
+	}
+
+	int64s, err := js.Get("ids").Int64Array()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(int64s) != 3 || int64s[1] != 2 {
+		t.Fatalf("Int64Array() = %v, want [1 2 3]", int64s)
+	}
+}
+
+func TestNumberOnNonNumberIsError(t *testing.T) {
+	js, err := NewJson([]byte(`{"name": "ckrissun"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := js.Get("name").Number(); err == nil {
+		t.Fatal("expected error asserting a string as json.Number")
+	}
+}
@@ -0,0 +1,82 @@
+package simplejson
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Stream lets callers process large JSON documents element-by-element,
+// via `json.Decoder`, instead of materializing the whole tree in memory.
+// It also reads NDJSON (newline-delimited JSON): each call to `Next`
+// simply returns the next top-level value, whatever the surrounding
+// whitespace looks like.
+//
+// Stream always decodes via `encoding/json.Decoder` directly, so it
+// does not go through the package-level `Codec` set by `SetCodec`.
+type Stream struct {
+	dec    *json.Decoder
+	closer io.Closer
+}
+
+// NewStream returns a pointer to a new `Stream` that decodes values
+// read from `r`.
+func NewStream(r io.Reader) *Stream {
+	s := &Stream{dec: json.NewDecoder(bufio.NewReader(r))}
+	if c, ok := r.(io.Closer); ok {
+		s.closer = c
+	}
+	return s
+}
+
+// Next decodes and returns the next top-level JSON value from the
+// stream, returning `io.EOF` once the stream is exhausted.
+func (s *Stream) Next() (*Json, error) {
+	var v interface{}
+	if err := s.dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return &Json{v}, nil
+}
+
+// Array opens a top-level JSON array, calls `fn` with each decoded
+// element in turn, and closes the array, so large array documents can
+// be scanned without loading every element into memory at once.
+func (s *Stream) Array(fn func(*Json) error) error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return errors.New("simplejson: expected '[' at start of array stream")
+	}
+
+	for s.dec.More() {
+		var v interface{}
+		if err := s.dec.Decode(&v); err != nil {
+			return err
+		}
+		if err := fn(&Json{v}); err != nil {
+			return err
+		}
+	}
+
+	tok, err = s.dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != ']' {
+		return errors.New("simplejson: expected ']' at end of array stream")
+	}
+	return nil
+}
+
+// Close releases the underlying reader if it implements `io.Closer`,
+// as is the case for streams created via `NewJsonStreamFromFile`.
+func (s *Stream) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
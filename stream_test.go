@@ -0,0 +1,74 @@
+package simplejson
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestStreamNextNDJSON(t *testing.T) {
+	s := NewStream(strings.NewReader("{\"a\":1}\n{\"a\":2}\n"))
+
+	var got []int
+	for {
+		js, err := s.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, js.Get("a").MustInt())
+	}
+
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("got %v, want [1 2]", got)
+	}
+}
+
+func TestStreamNextEmptyReturnsEOF(t *testing.T) {
+	s := NewStream(strings.NewReader(""))
+	if _, err := s.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Next() err = %v, want io.EOF", err)
+	}
+}
+
+func TestStreamArray(t *testing.T) {
+	s := NewStream(strings.NewReader(`[1, 2, 3]`))
+
+	var sum int
+	err := s.Array(func(js *Json) error {
+		sum += js.MustInt()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 6 {
+		t.Fatalf("sum = %d, want 6", sum)
+	}
+}
+
+func TestStreamArrayRejectsNonArray(t *testing.T) {
+	s := NewStream(strings.NewReader(`{"a":1}`))
+	err := s.Array(func(js *Json) error { return nil })
+	if err == nil {
+		t.Fatal("expected error opening a non-array as a stream array")
+	}
+}
+
+func TestStreamArrayPropagatesCallbackError(t *testing.T) {
+	s := NewStream(strings.NewReader(`[1, 2, 3]`))
+	boom := errors.New("boom")
+
+	err := s.Array(func(js *Json) error {
+		if js.MustInt() == 2 {
+			return boom
+		}
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("err = %v, want %v", err, boom)
+	}
+}